@@ -0,0 +1,202 @@
+package d2player
+
+import (
+	"github.com/OpenDiablo2/OpenDiablo2/d2common/d2enum"
+	"github.com/OpenDiablo2/OpenDiablo2/d2common/d2interface"
+	"github.com/OpenDiablo2/OpenDiablo2/d2common/d2resource"
+	"github.com/OpenDiablo2/OpenDiablo2/d2core/d2ui"
+)
+
+const beltColumns = 4
+
+const (
+	beltSlotWidth  = 29
+	beltSlotHeight = 28
+)
+
+const frameBeltBackground = 0
+
+// defaultBeltHotkeys binds columns 0-3 to the number keys 1-4.
+var defaultBeltHotkeys = [beltColumns]d2enum.Key{
+	d2enum.Key1,
+	d2enum.Key2,
+	d2enum.Key3,
+	d2enum.Key4,
+}
+
+// OnItemUsed is called whenever a belt slot is consumed.
+type OnItemUsed func(item InventoryItem)
+
+// Belt renders the character panel's belt slots, tracks the potions/scrolls carried in
+// them, and routes hotkey presses to consume the bottom item of a column.
+type Belt struct {
+	uiManager  *d2ui.UIManager
+	inventory  *Inventory
+	panel      *d2ui.Sprite
+	sprites    map[string]*d2ui.Sprite
+	originX    int
+	originY    int
+	columns    [beltColumns][]InventoryItem
+	hotkeys    [beltColumns]d2enum.Key
+	onItemUsed OnItemUsed
+}
+
+// NewBelt creates a belt bound to the given inventory, whose equipped EquippedSlotBelt
+// item determines how many rows each column can hold.
+func NewBelt(ui *d2ui.UIManager, inventory *Inventory, originX, originY int) *Belt {
+	return &Belt{
+		uiManager: ui,
+		inventory: inventory,
+		sprites:   make(map[string]*d2ui.Sprite),
+		originX:   originX,
+		originY:   originY,
+		hotkeys:   defaultBeltHotkeys,
+	}
+}
+
+// SetHotkeys overrides the default 1-4 hotkey bindings for the belt's columns.
+func (b *Belt) SetHotkeys(hotkeys [beltColumns]d2enum.Key) {
+	b.hotkeys = hotkeys
+}
+
+// SetOnItemUsed registers the callback fired whenever a belt slot is consumed.
+func (b *Belt) SetOnItemUsed(cb OnItemUsed) {
+	b.onItemUsed = cb
+}
+
+// Load the resources required by the belt.
+func (b *Belt) Load() {
+	b.panel, _ = b.uiManager.NewSprite(d2resource.InventoryCharacterPanel, d2resource.PaletteSky)
+}
+
+// rowCapacity returns how many rows deep each column can stack, based on the currently
+// equipped belt item.
+func (b *Belt) rowCapacity() int {
+	beltItem := b.inventory.grid.GetEquippedItem(d2enum.EquippedSlotBelt)
+	if beltItem == nil {
+		return 0
+	}
+
+	return beltItem.GetBeltCapacity()
+}
+
+// AddItem places item into the first belt column with room, routing overflow into the
+// next column. It returns false if every column is full.
+func (b *Belt) AddItem(item InventoryItem) bool {
+	capacity := b.rowCapacity()
+	if capacity == 0 {
+		return false
+	}
+
+	for col := range b.columns {
+		if len(b.columns[col]) < capacity {
+			b.columns[col] = append(b.columns[col], item)
+			return true
+		}
+	}
+
+	return false
+}
+
+// restoreColumns replaces the belt's contents with items rebuilt from saved state,
+// preserving each item's original column and stack order rather than repacking them
+// through AddItem.
+func (b *Belt) restoreColumns(saved [beltColumns][]InventoryItemState, toItem func(InventoryItemState) (InventoryItem, error)) {
+	for col := range saved {
+		items := make([]InventoryItem, 0, len(saved[col]))
+
+		for _, itemState := range saved[col] {
+			item, err := toItem(itemState)
+			if err != nil {
+				continue
+			}
+
+			items = append(items, item)
+		}
+
+		b.columns[col] = items
+	}
+}
+
+// UseSlot consumes the bottom item of the given column (the item most recently added to
+// it), shifting the remaining items down, and returns the item that was used (or nil if
+// the column was empty).
+func (b *Belt) UseSlot(col int) InventoryItem {
+	if col < 0 || col >= beltColumns || len(b.columns[col]) == 0 {
+		return nil
+	}
+
+	items := b.columns[col]
+	item := items[len(items)-1]
+	b.columns[col] = items[:len(items)-1]
+
+	if b.onItemUsed != nil {
+		b.onItemUsed(item)
+	}
+
+	return item
+}
+
+// OnKeyDown consumes the belt slot bound to key, if any, and reports whether a slot was
+// used.
+func (b *Belt) OnKeyDown(key d2enum.Key) bool {
+	for col, hotkey := range b.hotkeys {
+		if hotkey == key {
+			return b.UseSlot(col) != nil
+		}
+	}
+
+	return false
+}
+
+// Render draws the belt's background slots, then each column's bottom-most item, onto
+// the target surface.
+func (b *Belt) Render(target d2interface.Surface) {
+	b.renderBackground(target)
+
+	for col := range b.columns {
+		items := b.columns[col]
+		if len(items) == 0 {
+			continue
+		}
+
+		item := items[len(items)-1]
+
+		sprite, err := b.spriteForItem(item)
+		if err != nil {
+			continue
+		}
+
+		x := b.originX + col*beltSlotWidth
+		sprite.SetPosition(x, b.originY)
+		sprite.Render(target)
+	}
+}
+
+func (b *Belt) renderBackground(target d2interface.Surface) {
+	if b.panel == nil {
+		return
+	}
+
+	if err := b.panel.SetCurrentFrame(frameBeltBackground); err != nil {
+		return
+	}
+
+	b.panel.SetPosition(b.originX, b.originY+beltSlotHeight)
+	b.panel.Render(target)
+}
+
+func (b *Belt) spriteForItem(item InventoryItem) (*d2ui.Sprite, error) {
+	if sprite, found := b.sprites[item.GetItemCode()]; found {
+		return sprite, nil
+	}
+
+	sprite, err := b.uiManager.NewSprite(item.GetItemCode(), d2resource.PaletteSky)
+	if err != nil {
+		return nil, err
+	}
+
+	b.sprites[item.GetItemCode()] = sprite
+
+	return sprite, nil
+}
@@ -0,0 +1,124 @@
+package d2player
+
+import (
+	"testing"
+
+	"github.com/OpenDiablo2/OpenDiablo2/d2common/d2enum"
+	"github.com/OpenDiablo2/OpenDiablo2/d2core/d2ui"
+)
+
+func newTestBelt(beltCapacity int) *Belt {
+	grid := newTestItemGrid(4, 4)
+	grid.ChangeEquippedSlot(d2enum.EquippedSlotBelt, &fakeInventoryItem{code: "vbl", codes: []string{"vbl"}, beltCap: beltCapacity})
+
+	return &Belt{
+		inventory: &Inventory{grid: grid},
+		sprites:   make(map[string]*d2ui.Sprite),
+		hotkeys:   defaultBeltHotkeys,
+	}
+}
+
+func TestBeltAddItemFillsColumnsBeforeOverflowing(t *testing.T) {
+	belt := newTestBelt(2)
+
+	for i := 0; i < beltColumns*2; i++ {
+		item := &fakeInventoryItem{code: "hp1", codes: []string{"hp1"}}
+		if !belt.AddItem(item) {
+			t.Fatalf("expected item %d to fit (capacity %d per column)", i, 2)
+		}
+	}
+
+	overflow := &fakeInventoryItem{code: "hp1", codes: []string{"hp1"}}
+	if belt.AddItem(overflow) {
+		t.Fatal("expected the belt to be full and reject further items")
+	}
+}
+
+func TestBeltAddItemWithZeroCapacityBeltFails(t *testing.T) {
+	belt := newTestBelt(0)
+
+	if belt.AddItem(&fakeInventoryItem{code: "hp1", codes: []string{"hp1"}}) {
+		t.Fatal("expected AddItem to fail when the equipped belt has no capacity")
+	}
+}
+
+func TestBeltUseSlotConsumesBottomItemAndShiftsDown(t *testing.T) {
+	belt := newTestBelt(2)
+
+	// the most recently added item sits at the bottom of its column
+	top := &fakeInventoryItem{code: "hp1", codes: []string{"hp1"}}
+	bottom := &fakeInventoryItem{code: "hp2", codes: []string{"hp2"}}
+
+	belt.AddItem(top)
+	belt.AddItem(bottom)
+
+	var used InventoryItem
+
+	belt.SetOnItemUsed(func(item InventoryItem) { used = item })
+
+	got := belt.UseSlot(0)
+	if got != bottom {
+		t.Fatalf("expected UseSlot to consume the most recently added item, got %+v", got)
+	}
+
+	if used != bottom {
+		t.Fatalf("expected OnItemUsed to report the consumed item")
+	}
+
+	got = belt.UseSlot(0)
+	if got != top {
+		t.Fatalf("expected the remaining item to shift down to be consumed next, got %+v", got)
+	}
+
+	if belt.UseSlot(0) != nil {
+		t.Fatal("expected UseSlot on an empty column to return nil")
+	}
+}
+
+func TestBeltOnKeyDownRoutesToTheBoundColumn(t *testing.T) {
+	belt := newTestBelt(1)
+
+	item := &fakeInventoryItem{code: "hp1", codes: []string{"hp1"}}
+	belt.columns[2] = append(belt.columns[2], item)
+
+	if !belt.OnKeyDown(d2enum.Key3) {
+		t.Fatal("expected Key3 to consume column 2's item")
+	}
+
+	if len(belt.columns[2]) != 0 {
+		t.Fatal("expected the column to be empty after consumption")
+	}
+
+	if belt.OnKeyDown(d2enum.Key3) {
+		t.Fatal("expected a second press on an empty column to report no item consumed")
+	}
+}
+
+func TestBeltRestoreColumnsPreservesColumnAndOrder(t *testing.T) {
+	belt := newTestBelt(2)
+
+	saved := [beltColumns][]InventoryItemState{
+		2: {{Codes: []string{"hp1"}}, {Codes: []string{"hp2"}}},
+	}
+
+	toItem := func(state InventoryItemState) (InventoryItem, error) {
+		return &fakeInventoryItem{code: state.Codes[0], codes: state.Codes}, nil
+	}
+
+	belt.restoreColumns(saved, toItem)
+
+	if len(belt.columns[2]) != 2 {
+		t.Fatalf("expected column 2 to hold 2 restored items, got %d", len(belt.columns[2]))
+	}
+
+	if belt.columns[2][0].GetItemCode() != "hp1" || belt.columns[2][1].GetItemCode() != "hp2" {
+		t.Fatalf("expected restored order [hp1 hp2], got [%s %s]",
+			belt.columns[2][0].GetItemCode(), belt.columns[2][1].GetItemCode())
+	}
+
+	for col := range belt.columns {
+		if col != 2 && len(belt.columns[col]) != 0 {
+			t.Fatalf("expected column %d to stay empty, got %d items", col, len(belt.columns[col]))
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package d2player
+
+import (
+	"github.com/OpenDiablo2/OpenDiablo2/d2common/d2enum"
+	"github.com/OpenDiablo2/OpenDiablo2/d2common/d2interface"
+)
+
+// OnItemMoved is called whenever an item is picked up from, or placed into, the grid.
+type OnItemMoved func(item InventoryItem)
+
+// OnItemEquipped is called whenever an item is equipped into, or unequipped from, a slot.
+type OnItemEquipped func(item InventoryItem, slot d2enum.EquippedSlot)
+
+// SetOnItemMoved registers the callback fired whenever an item is picked up or dropped
+// within the grid, so other panels can react (e.g. refreshing a stats display).
+func (g *Inventory) SetOnItemMoved(cb OnItemMoved) {
+	g.onItemMoved = cb
+}
+
+// SetOnItemEquipped registers the callback fired whenever an item is equipped or
+// unequipped.
+func (g *Inventory) SetOnItemEquipped(cb OnItemEquipped) {
+	g.onItemEquipped = cb
+}
+
+// CursorItem returns the item currently attached to the cursor, or nil if the cursor
+// isn't carrying anything.
+func (g *Inventory) CursorItem() InventoryItem {
+	return g.cursorItem
+}
+
+// OnMouseButtonDown handles inventory-panel clicks: left-click picks up, places, or
+// swaps an item; right-click auto-equips the clicked item into the first compatible
+// empty slot. It returns true if the click was consumed.
+func (g *Inventory) OnMouseButtonDown(event d2interface.MouseEvent) bool {
+	if !g.isOpen {
+		return false
+	}
+
+	switch event.Button() {
+	case d2enum.MouseButtonLeft:
+		return g.handleLeftClick(event.X(), event.Y())
+	case d2enum.MouseButtonRight:
+		return g.handleRightClick(event.X(), event.Y())
+	default:
+		return false
+	}
+}
+
+func (g *Inventory) handleLeftClick(mx, my int) bool {
+	if slot, found := g.grid.ScreenToSlot(mx, my); found {
+		return g.handleGridClick(slot)
+	}
+
+	if slot, found := g.grid.EquippedSlotAt(mx, my); found {
+		return g.handleEquippedClick(slot)
+	}
+
+	return false
+}
+
+func (g *Inventory) handleGridClick(slot ItemGridSlot) bool {
+	existing := g.grid.ItemAt(slot)
+
+	var moved InventoryItem
+
+	switch {
+	case g.cursorItem == nil && existing == nil:
+		return false
+	case g.cursorItem == nil:
+		g.grid.Remove(existing)
+		g.cursorItem = existing
+		moved = existing
+	case existing == nil:
+		moved = g.cursorItem
+
+		if !g.grid.PlaceAt(g.cursorItem, slot) {
+			return false
+		}
+
+		g.cursorItem = nil
+	default:
+		g.grid.Remove(existing)
+
+		if !g.grid.PlaceAt(g.cursorItem, slot) {
+			g.grid.PlaceAt(existing, existing.InventoryGridSlot())
+			return false
+		}
+
+		g.cursorItem = existing
+		moved = existing
+	}
+
+	if g.onItemMoved != nil {
+		g.onItemMoved(moved)
+	}
+
+	return true
+}
+
+func (g *Inventory) handleEquippedClick(slot d2enum.EquippedSlot) bool {
+	if g.cursorItem != nil && !itemFitsSlot(g.cursorItem, slot) {
+		return false
+	}
+
+	current := g.grid.GetEquippedItem(slot)
+	if current == nil && g.cursorItem == nil {
+		return false
+	}
+
+	g.grid.ChangeEquippedSlot(slot, g.cursorItem)
+	g.cursorItem = current
+
+	if g.onItemEquipped != nil {
+		g.onItemEquipped(g.grid.GetEquippedItem(slot), slot)
+	}
+
+	return true
+}
+
+func (g *Inventory) handleRightClick(mx, my int) bool {
+	slot, found := g.grid.ScreenToSlot(mx, my)
+	if !found {
+		return false
+	}
+
+	item := g.grid.ItemAt(slot)
+	if item == nil {
+		return false
+	}
+
+	for _, equipSlot := range item.GetEquipmentSlots() {
+		if g.grid.GetEquippedItem(equipSlot) != nil {
+			continue
+		}
+
+		g.grid.Remove(item)
+		g.grid.ChangeEquippedSlot(equipSlot, item)
+
+		if g.onItemEquipped != nil {
+			g.onItemEquipped(item, equipSlot)
+		}
+
+		return true
+	}
+
+	return false
+}
+
+func itemFitsSlot(item InventoryItem, slot d2enum.EquippedSlot) bool {
+	for _, s := range item.GetEquipmentSlots() {
+		if s == slot {
+			return true
+		}
+	}
+
+	return false
+}
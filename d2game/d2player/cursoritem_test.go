@@ -0,0 +1,189 @@
+package d2player
+
+import (
+	"testing"
+
+	"github.com/OpenDiablo2/OpenDiablo2/d2common/d2enum"
+)
+
+func newTestInventoryForCursor() *Inventory {
+	return &Inventory{grid: newTestItemGrid(4, 4)}
+}
+
+func TestHandleGridClickPicksUpItem(t *testing.T) {
+	inv := newTestInventoryForCursor()
+	item := &fakeInventoryItem{code: "jav", codes: []string{"jav"}, width: 1, height: 1}
+
+	if !inv.grid.PlaceAt(item, ItemGridSlot{X: 0, Y: 0}) {
+		t.Fatal("setup: failed to place item")
+	}
+
+	var moved InventoryItem
+	inv.SetOnItemMoved(func(i InventoryItem) { moved = i })
+
+	if !inv.handleGridClick(ItemGridSlot{X: 0, Y: 0}) {
+		t.Fatal("expected pick-up to succeed")
+	}
+
+	if inv.CursorItem() != item {
+		t.Fatal("expected the clicked item to be on the cursor")
+	}
+
+	if inv.grid.ItemAt(ItemGridSlot{X: 0, Y: 0}) != nil {
+		t.Fatal("expected the grid slot to be empty after pick-up")
+	}
+
+	if moved != item {
+		t.Fatalf("expected OnItemMoved to report the picked-up item, got %+v", moved)
+	}
+}
+
+func TestHandleGridClickPlacesIntoEmptySlot(t *testing.T) {
+	inv := newTestInventoryForCursor()
+	item := &fakeInventoryItem{code: "jav", codes: []string{"jav"}, width: 1, height: 1}
+	inv.cursorItem = item
+
+	var moved InventoryItem
+	inv.SetOnItemMoved(func(i InventoryItem) { moved = i })
+
+	if !inv.handleGridClick(ItemGridSlot{X: 1, Y: 1}) {
+		t.Fatal("expected placement into an empty slot to succeed")
+	}
+
+	if inv.CursorItem() != nil {
+		t.Fatal("expected the cursor to be empty after placing")
+	}
+
+	if inv.grid.ItemAt(ItemGridSlot{X: 1, Y: 1}) != item {
+		t.Fatal("expected the item to land in the clicked slot")
+	}
+
+	if moved != item {
+		t.Fatalf("expected OnItemMoved to report the placed item, not nil; got %+v", moved)
+	}
+}
+
+func TestHandleGridClickSwapsWithCursor(t *testing.T) {
+	inv := newTestInventoryForCursor()
+
+	onGrid := &fakeInventoryItem{code: "rin", codes: []string{"rin"}, width: 1, height: 1}
+	if !inv.grid.PlaceAt(onGrid, ItemGridSlot{X: 0, Y: 0}) {
+		t.Fatal("setup: failed to place item")
+	}
+
+	onCursor := &fakeInventoryItem{code: "amu", codes: []string{"amu"}, width: 1, height: 1}
+	inv.cursorItem = onCursor
+
+	var moved InventoryItem
+	inv.SetOnItemMoved(func(i InventoryItem) { moved = i })
+
+	if !inv.handleGridClick(ItemGridSlot{X: 0, Y: 0}) {
+		t.Fatal("expected the swap to succeed")
+	}
+
+	if inv.CursorItem() != onGrid {
+		t.Fatal("expected the grid's item to end up on the cursor")
+	}
+
+	if inv.grid.ItemAt(ItemGridSlot{X: 0, Y: 0}) != onCursor {
+		t.Fatal("expected the cursor's item to end up in the grid")
+	}
+
+	if moved != onGrid {
+		t.Fatalf("expected OnItemMoved to report the item grabbed off the grid, got %+v", moved)
+	}
+}
+
+func TestHandleEquippedClickSwapsItem(t *testing.T) {
+	inv := newTestInventoryForCursor()
+
+	equipped := &fakeInventoryItem{code: "rin", codes: []string{"rin"}, equipSlots: []d2enum.EquippedSlot{d2enum.EquippedSlotLeftHand}}
+	inv.grid.ChangeEquippedSlot(d2enum.EquippedSlotLeftHand, equipped)
+
+	held := &fakeInventoryItem{code: "amu", codes: []string{"amu"}, equipSlots: []d2enum.EquippedSlot{d2enum.EquippedSlotLeftHand}}
+	inv.cursorItem = held
+
+	var equippedEvent InventoryItem
+	inv.SetOnItemEquipped(func(item InventoryItem, slot d2enum.EquippedSlot) { equippedEvent = item })
+
+	if !inv.handleEquippedClick(d2enum.EquippedSlotLeftHand) {
+		t.Fatal("expected the equip swap to succeed")
+	}
+
+	if inv.CursorItem() != equipped {
+		t.Fatal("expected the previously equipped item to end up on the cursor")
+	}
+
+	if inv.grid.GetEquippedItem(d2enum.EquippedSlotLeftHand) != held {
+		t.Fatal("expected the held item to be equipped")
+	}
+
+	if equippedEvent != held {
+		t.Fatalf("expected OnItemEquipped to report the newly equipped item, got %+v", equippedEvent)
+	}
+}
+
+func TestHandleEquippedClickRejectsIncompatibleItem(t *testing.T) {
+	inv := newTestInventoryForCursor()
+
+	held := &fakeInventoryItem{code: "crn", codes: []string{"crn"}, equipSlots: []d2enum.EquippedSlot{d2enum.EquippedSlotHead}}
+	inv.cursorItem = held
+
+	if inv.handleEquippedClick(d2enum.EquippedSlotLeftHand) {
+		t.Fatal("expected an incompatible item to be rejected")
+	}
+
+	if inv.CursorItem() != held {
+		t.Fatal("expected the held item to remain on the cursor")
+	}
+}
+
+func TestHandleEquippedClickNoOpOnEmptySlotWithEmptyCursor(t *testing.T) {
+	inv := newTestInventoryForCursor()
+
+	called := false
+	inv.SetOnItemEquipped(func(item InventoryItem, slot d2enum.EquippedSlot) { called = true })
+
+	if inv.handleEquippedClick(d2enum.EquippedSlotLeftHand) {
+		t.Fatal("expected clicking an empty slot with an empty cursor to be a no-op")
+	}
+
+	if called {
+		t.Fatal("expected OnItemEquipped not to fire for a no-op click")
+	}
+}
+
+func TestHandleRightClickAutoEquipsFirstCompatibleSlot(t *testing.T) {
+	inv := newTestInventoryForCursor()
+
+	item := &fakeInventoryItem{
+		code: "rin", codes: []string{"rin"}, width: 1, height: 1,
+		equipSlots: []d2enum.EquippedSlot{d2enum.EquippedSlotLeftHand, d2enum.EquippedSlotRightHand},
+	}
+
+	if !inv.grid.PlaceAt(item, ItemGridSlot{X: 0, Y: 0}) {
+		t.Fatal("setup: failed to place item")
+	}
+
+	// occupy the first compatible slot so auto-equip has to fall through to the second
+	inv.grid.ChangeEquippedSlot(d2enum.EquippedSlotLeftHand, &fakeInventoryItem{code: "rin", codes: []string{"rin"}})
+
+	var equippedSlot d2enum.EquippedSlot
+	inv.SetOnItemEquipped(func(i InventoryItem, slot d2enum.EquippedSlot) { equippedSlot = slot })
+
+	if !inv.handleRightClick(0, 0) {
+		t.Fatal("expected right-click auto-equip to succeed")
+	}
+
+	if inv.grid.GetEquippedItem(d2enum.EquippedSlotRightHand) != item {
+		t.Fatal("expected the item to be equipped into the first empty compatible slot")
+	}
+
+	if equippedSlot != d2enum.EquippedSlotRightHand {
+		t.Fatalf("expected OnItemEquipped to report EquippedSlotRightHand, got %v", equippedSlot)
+	}
+
+	if inv.grid.ItemAt(ItemGridSlot{X: 0, Y: 0}) != nil {
+		t.Fatal("expected the item to be removed from the grid once equipped")
+	}
+}
@@ -2,7 +2,9 @@ package d2player
 
 import (
 	"fmt"
+	"io"
 	"log"
+	"strings"
 
 	"github.com/OpenDiablo2/OpenDiablo2/d2core/d2records"
 
@@ -27,23 +29,29 @@ const (
 
 // Inventory represents the inventory
 type Inventory struct {
-	asset       *d2asset.AssetManager
-	item        *diablo2item.ItemFactory
-	uiManager   *d2ui.UIManager
-	frame       *d2ui.UIFrame
-	panel       *d2ui.Sprite
-	grid        *ItemGrid
-	itemTooltip *d2ui.Tooltip
-	closeButton *d2ui.Button
-	hoverX      int
-	hoverY      int
-	originX     int
-	originY     int
-	lastMouseX  int
-	lastMouseY  int
-	hovering    bool
-	isOpen      bool
-	onCloseCb   func()
+	asset          *d2asset.AssetManager
+	item           *diablo2item.ItemFactory
+	uiManager      *d2ui.UIManager
+	frame          *d2ui.UIFrame
+	panel          *d2ui.Sprite
+	grid           *ItemGrid
+	itemTooltip    *d2ui.Tooltip
+	closeButton    *d2ui.Button
+	hoverX         int
+	hoverY         int
+	originX        int
+	originY        int
+	lastMouseX     int
+	lastMouseY     int
+	hovering       bool
+	isOpen         bool
+	onCloseCb      func()
+	stateReader    io.Reader
+	stateWriter    io.Writer
+	cursorItem     InventoryItem
+	onItemMoved    OnItemMoved
+	onItemEquipped OnItemEquipped
+	belt           *Belt
 }
 
 // NewInventory creates an inventory instance and returns a pointer to it
@@ -90,6 +98,13 @@ func (g *Inventory) Open() {
 func (g *Inventory) Close() {
 	g.isOpen = false
 	g.closeButton.SetVisible(false)
+
+	if g.stateWriter != nil {
+		if err := g.Save(g.stateWriter); err != nil {
+			log.Println(err)
+		}
+	}
+
 	g.onCloseCb()
 }
 
@@ -98,6 +113,20 @@ func (g *Inventory) SetOnCloseCb(cb func()) {
 	g.onCloseCb = cb
 }
 
+// SetBelt attaches the belt that belt-compatible items (see beltCompatiblePrefixes) are
+// routed into instead of the grid when the inventory is loaded.
+func (g *Inventory) SetBelt(belt *Belt) {
+	g.belt = belt
+}
+
+// SetPersistence wires the inventory up to a save location: r is read once during Load
+// to restore a previously saved state, and w is written to whenever the inventory is
+// closed so the game loop can persist it across restarts. Either may be nil.
+func (g *Inventory) SetPersistence(r io.Reader, w io.Writer) {
+	g.stateReader = r
+	g.stateWriter = w
+}
+
 // Load the resources required by the inventory
 func (g *Inventory) Load() {
 	g.frame = d2ui.NewUIFrame(g.asset, g.uiManager, d2ui.FrameRight)
@@ -109,26 +138,20 @@ func (g *Inventory) Load() {
 
 	g.panel, _ = g.uiManager.NewSprite(d2resource.InventoryCharacterPanel, d2resource.PaletteSky)
 
-	// https://github.com/OpenDiablo2/OpenDiablo2/issues/795
-	testInventoryCodes := [][]string{
-		{"kit", "Crimson", "of the Bat", "of Frost"},
-		{"rin", "Steel", "of Shock"},
-		{"jav"},
-		{"buc"},
-	}
-
-	inventoryItems := make([]InventoryItem, 0)
-
-	for idx := range testInventoryCodes {
-		item, err := g.item.NewItem(testInventoryCodes[idx]...)
-		if err != nil {
-			continue
+	if g.stateReader != nil {
+		if err := g.LoadState(g.stateReader); err != nil {
+			log.Println(err)
+		} else {
+			return
 		}
-
-		item.Identify()
-		inventoryItems = append(inventoryItems, item)
 	}
 
+	g.loadDefaultItems()
+}
+
+// loadDefaultItems seeds the inventory with a placeholder loadout, used when there's no
+// saved state to restore (e.g. a brand new character).
+func (g *Inventory) loadDefaultItems() {
 	// https://github.com/OpenDiablo2/OpenDiablo2/issues/795
 	testEquippedItemCodes := map[d2enum.EquippedSlot][]string{
 		d2enum.EquippedSlotLeftArm:   {"wnd"},
@@ -152,12 +175,55 @@ func (g *Inventory) Load() {
 		g.grid.ChangeEquippedSlot(slot, item)
 	}
 
+	// https://github.com/OpenDiablo2/OpenDiablo2/issues/795
+	testInventoryCodes := [][]string{
+		{"kit", "Crimson", "of the Bat", "of Frost"},
+		{"rin", "Steel", "of Shock"},
+		{"jav"},
+		{"buc"},
+		{"hp1"},
+		{"hp2"},
+		{"mp1"},
+		{"rvs"},
+	}
+
+	inventoryItems := make([]InventoryItem, 0)
+
+	for idx := range testInventoryCodes {
+		item, err := g.item.NewItem(testInventoryCodes[idx]...)
+		if err != nil {
+			continue
+		}
+
+		item.Identify()
+
+		if g.belt != nil && isBeltCompatible(item.GetItemCode()) && g.belt.AddItem(item) {
+			continue
+		}
+
+		inventoryItems = append(inventoryItems, item)
+	}
+
 	_, err := g.grid.Add(inventoryItems...)
 	if err != nil {
 		fmt.Printf("could not add items to the inventory, err: %v\n", err)
 	}
 }
 
+// beltCompatiblePrefixes are the item code prefixes routed into the belt rather than the
+// grid: healing/mana potions (hp*/mp*) and rejuvenation potions (rvs/rvl).
+var beltCompatiblePrefixes = []string{"hp", "mp", "rvs", "rvl"}
+
+func isBeltCompatible(code string) bool {
+	for _, prefix := range beltCompatiblePrefixes {
+		if strings.HasPrefix(code, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Render draws the inventory onto the given surface
 func (g *Inventory) Render(target d2interface.Surface) {
 	if !g.isOpen {
@@ -171,6 +237,21 @@ func (g *Inventory) Render(target d2interface.Surface) {
 
 	g.grid.Render(target)
 	g.renderItemHover(target)
+	g.renderCursorItem(target)
+}
+
+func (g *Inventory) renderCursorItem(target d2interface.Surface) {
+	if g.cursorItem == nil {
+		return
+	}
+
+	sprite, err := g.grid.spriteForItem(g.cursorItem)
+	if err != nil {
+		return
+	}
+
+	sprite.SetPosition(g.lastMouseX, g.lastMouseY)
+	sprite.Render(target)
 }
 
 func (g *Inventory) renderFrame(target d2interface.Surface) error {
@@ -0,0 +1,30 @@
+package d2player
+
+import (
+	"github.com/OpenDiablo2/OpenDiablo2/d2common/d2enum"
+)
+
+// ItemGridSlot describes the top-left cell a grid item occupies within an ItemGrid.
+type ItemGridSlot struct {
+	X, Y int
+}
+
+// InventoryItem is anything that can be placed inside an Inventory's ItemGrid or
+// attached to one of its equipped slots.
+type InventoryItem interface {
+	GetItemCode() string
+	GetItemCodes() []string
+	GetItemName() string
+	GetItemDescription() []string
+	InventoryGridSlot() ItemGridSlot
+	SetInventoryGridSlot(slot ItemGridSlot)
+	GetInventoryItemSize() (width, height int)
+	GetEquipmentSlots() []d2enum.EquippedSlot
+	Identify()
+	IsIdentified() bool
+
+	// GetBeltCapacity returns how many rows deep this item can stack within a single
+	// belt column. It's only meaningful for items equipped in EquippedSlotBelt; other
+	// items return 0.
+	GetBeltCapacity() int
+}
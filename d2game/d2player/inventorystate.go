@@ -0,0 +1,126 @@
+package d2player
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/OpenDiablo2/OpenDiablo2/d2common/d2enum"
+)
+
+// InventoryItemState is the persisted representation of a single item: the codes used
+// to reconstruct it through the item factory (base code followed by any prefix/suffix
+// affix names), whether it has been identified, and where it sits in the grid.
+type InventoryItemState struct {
+	Codes      []string `json:"codes"`
+	Identified bool     `json:"identified"`
+	GridX      int      `json:"gridX"`
+	GridY      int      `json:"gridY"`
+}
+
+// InventoryState is the full persisted state of an Inventory: every item sitting in the
+// free-form grid, whatever is attached to each equipped slot, and whatever is stacked
+// in each belt column.
+type InventoryState struct {
+	GridItems     []InventoryItemState                        `json:"gridItems"`
+	EquippedItems map[d2enum.EquippedSlot]InventoryItemState `json:"equippedItems"`
+	BeltItems     [beltColumns][]InventoryItemState           `json:"beltItems"`
+}
+
+// Save encodes the inventory's current items, equipped slots, and belt contents to w.
+func (g *Inventory) Save(w io.Writer) error {
+	state := InventoryState{
+		GridItems:     make([]InventoryItemState, 0, len(g.grid.items)),
+		EquippedItems: make(map[d2enum.EquippedSlot]InventoryItemState),
+	}
+
+	for idx := range g.grid.items {
+		state.GridItems = append(state.GridItems, itemToState(g.grid.items[idx]))
+	}
+
+	for slot, item := range g.grid.equipped {
+		state.EquippedItems[slot] = itemToState(item)
+	}
+
+	if g.belt != nil {
+		for col := range g.belt.columns {
+			for _, item := range g.belt.columns[col] {
+				state.BeltItems[col] = append(state.BeltItems[col], itemToState(item))
+			}
+		}
+	}
+
+	return json.NewEncoder(w).Encode(state)
+}
+
+// LoadState reconstructs the inventory's items and equipped slots from r, replacing
+// whatever is currently loaded. It's distinct from Load, which loads the inventory's
+// UI resources.
+func (g *Inventory) LoadState(r io.Reader) error {
+	var state InventoryState
+
+	if err := json.NewDecoder(r).Decode(&state); err != nil {
+		return err
+	}
+
+	g.grid.items = nil
+	g.grid.occupied = make(map[ItemGridSlot]bool)
+	g.grid.equipped = make(map[d2enum.EquippedSlot]InventoryItem)
+
+	for slot, itemState := range state.EquippedItems {
+		item, err := g.stateToItem(itemState)
+		if err != nil {
+			continue
+		}
+
+		g.grid.ChangeEquippedSlot(slot, item)
+	}
+
+	if g.belt != nil {
+		g.belt.restoreColumns(state.BeltItems, g.stateToItem)
+	}
+
+	fallback := make([]InventoryItem, 0, len(state.GridItems))
+
+	for idx := range state.GridItems {
+		item, err := g.stateToItem(state.GridItems[idx])
+		if err != nil {
+			continue
+		}
+
+		if !g.grid.PlaceAt(item, item.InventoryGridSlot()) {
+			fallback = append(fallback, item)
+		}
+	}
+
+	if _, err := g.grid.Add(fallback...); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func itemToState(item InventoryItem) InventoryItemState {
+	slot := item.InventoryGridSlot()
+
+	return InventoryItemState{
+		Codes:      item.GetItemCodes(),
+		Identified: item.IsIdentified(),
+		GridX:      slot.X,
+		GridY:      slot.Y,
+	}
+}
+
+func (g *Inventory) stateToItem(state InventoryItemState) (InventoryItem, error) {
+	item, err := g.item.NewItem(state.Codes...)
+	if err != nil {
+		return nil, err
+	}
+
+	if state.Identified {
+		item.Identify()
+	}
+
+	item.SetInventoryGridSlot(ItemGridSlot{X: state.GridX, Y: state.GridY})
+
+	return item, nil
+}
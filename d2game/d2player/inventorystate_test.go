@@ -0,0 +1,120 @@
+package d2player
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/OpenDiablo2/OpenDiablo2/d2common/d2enum"
+	"github.com/OpenDiablo2/OpenDiablo2/d2core/d2records"
+)
+
+// newTestItemGrid builds an ItemGrid of the given dimensions without needing a real
+// asset/UI manager, so grid placement logic can be tested in isolation.
+func newTestItemGrid(width, height int) *ItemGrid {
+	record := &d2records.InventoryRecord{}
+	record.Grid.Box.Width = width
+	record.Grid.Box.Height = height
+	record.EquippedSlotBoxes = make(map[d2enum.EquippedSlot]d2records.InventoryBox)
+
+	return NewItemGrid(nil, nil, record)
+}
+
+// fakeInventoryItem is a minimal InventoryItem test double that doesn't depend on the
+// real item factory, so it can exercise ItemGrid/Belt/state logic in isolation.
+type fakeInventoryItem struct {
+	code       string
+	codes      []string
+	width      int
+	height     int
+	slot       ItemGridSlot
+	identified bool
+	equipSlots []d2enum.EquippedSlot
+	beltCap    int
+}
+
+func (f *fakeInventoryItem) GetItemCode() string                     { return f.code }
+func (f *fakeInventoryItem) GetItemCodes() []string                  { return f.codes }
+func (f *fakeInventoryItem) GetItemName() string                     { return f.code }
+func (f *fakeInventoryItem) GetItemDescription() []string            { return []string{f.code} }
+func (f *fakeInventoryItem) InventoryGridSlot() ItemGridSlot         { return f.slot }
+func (f *fakeInventoryItem) SetInventoryGridSlot(slot ItemGridSlot)  { f.slot = slot }
+func (f *fakeInventoryItem) GetEquipmentSlots() []d2enum.EquippedSlot { return f.equipSlots }
+func (f *fakeInventoryItem) Identify()                               { f.identified = true }
+func (f *fakeInventoryItem) IsIdentified() bool                      { return f.identified }
+func (f *fakeInventoryItem) GetBeltCapacity() int                    { return f.beltCap }
+
+func (f *fakeInventoryItem) GetInventoryItemSize() (width, height int) {
+	return f.width, f.height
+}
+
+func TestInventoryStateJSONRoundTrip(t *testing.T) {
+	state := InventoryState{
+		GridItems: []InventoryItemState{
+			{Codes: []string{"kit", "Crimson"}, Identified: true, GridX: 2, GridY: 3},
+			{Codes: []string{"jav"}, Identified: false, GridX: 0, GridY: 0},
+		},
+		EquippedItems: map[d2enum.EquippedSlot]InventoryItemState{
+			d2enum.EquippedSlotBelt: {Codes: []string{"vbl"}, Identified: true},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(state); err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+
+	var decoded InventoryState
+	if err := json.NewDecoder(&buf).Decode(&decoded); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+
+	if len(decoded.GridItems) != len(state.GridItems) {
+		t.Fatalf("expected %d grid items, got %d", len(state.GridItems), len(decoded.GridItems))
+	}
+
+	for idx := range state.GridItems {
+		want, got := state.GridItems[idx], decoded.GridItems[idx]
+		if got.GridX != want.GridX || got.GridY != want.GridY {
+			t.Errorf("item %d: expected position (%d,%d), got (%d,%d)", idx, want.GridX, want.GridY, got.GridX, got.GridY)
+		}
+
+		if got.Identified != want.Identified {
+			t.Errorf("item %d: expected identified=%v, got %v", idx, want.Identified, got.Identified)
+		}
+	}
+
+	beltItem, found := decoded.EquippedItems[d2enum.EquippedSlotBelt]
+	if !found {
+		t.Fatal("expected belt slot to survive the round trip")
+	}
+
+	if beltItem.Codes[0] != "vbl" {
+		t.Errorf("expected belt item code \"vbl\", got %q", beltItem.Codes[0])
+	}
+}
+
+func TestItemToStateCapturesGridPosition(t *testing.T) {
+	item := &fakeInventoryItem{code: "jav", codes: []string{"jav"}, width: 1, height: 3, slot: ItemGridSlot{X: 4, Y: 1}}
+
+	state := itemToState(item)
+
+	if state.GridX != 4 || state.GridY != 1 {
+		t.Fatalf("expected position (4,1), got (%d,%d)", state.GridX, state.GridY)
+	}
+}
+
+func TestPlaceAtKeepsSavedGridSlot(t *testing.T) {
+	grid := newTestItemGrid(10, 10)
+
+	saved := &fakeInventoryItem{code: "jav", codes: []string{"jav"}, width: 1, height: 1, slot: ItemGridSlot{X: 5, Y: 5}}
+
+	if !grid.PlaceAt(saved, saved.InventoryGridSlot()) {
+		t.Fatal("expected PlaceAt to succeed for a free slot")
+	}
+
+	got := saved.InventoryGridSlot()
+	if got.X != 5 || got.Y != 5 {
+		t.Fatalf("expected the item to keep its saved slot (5,5), landed at (%d,%d) instead", got.X, got.Y)
+	}
+}
@@ -0,0 +1,251 @@
+package d2player
+
+import (
+	"errors"
+
+	"github.com/OpenDiablo2/OpenDiablo2/d2common/d2enum"
+	"github.com/OpenDiablo2/OpenDiablo2/d2common/d2interface"
+	"github.com/OpenDiablo2/OpenDiablo2/d2common/d2resource"
+	"github.com/OpenDiablo2/OpenDiablo2/d2core/d2asset"
+	"github.com/OpenDiablo2/OpenDiablo2/d2core/d2records"
+	"github.com/OpenDiablo2/OpenDiablo2/d2core/d2ui"
+)
+
+const (
+	itemGridCellWidth  = 29
+	itemGridCellHeight = 28
+)
+
+// ItemGrid tracks and renders the items placed within an inventory panel, both the
+// free-form grid cells and the fixed equipped slots.
+type ItemGrid struct {
+	asset     *d2asset.AssetManager
+	uiManager *d2ui.UIManager
+	record    *d2records.InventoryRecord
+	items     []InventoryItem
+	sprites   map[string]*d2ui.Sprite
+	equipped  map[d2enum.EquippedSlot]InventoryItem
+	occupied  map[ItemGridSlot]bool
+}
+
+// NewItemGrid creates an ItemGrid bound to the given inventory layout record.
+func NewItemGrid(asset *d2asset.AssetManager, ui *d2ui.UIManager, record *d2records.InventoryRecord) *ItemGrid {
+	return &ItemGrid{
+		asset:     asset,
+		uiManager: ui,
+		record:    record,
+		sprites:   make(map[string]*d2ui.Sprite),
+		equipped:  make(map[d2enum.EquippedSlot]InventoryItem),
+		occupied:  make(map[ItemGridSlot]bool),
+	}
+}
+
+// Render draws every grid item and equipped item onto the target surface.
+func (g *ItemGrid) Render(target d2interface.Surface) {
+	for idx := range g.items {
+		item := g.items[idx]
+
+		sprite, err := g.spriteForItem(item)
+		if err != nil {
+			continue
+		}
+
+		x, y := g.SlotToScreen(item.InventoryGridSlot())
+		sprite.SetPosition(x, y)
+		sprite.Render(target)
+	}
+
+	for slot := range g.equipped {
+		item := g.equipped[slot]
+
+		sprite, err := g.spriteForItem(item)
+		if err != nil {
+			continue
+		}
+
+		box, found := g.record.EquippedSlotBoxes[slot]
+		if !found {
+			continue
+		}
+
+		sprite.SetPosition(box.Left, box.Top)
+		sprite.Render(target)
+	}
+}
+
+func (g *ItemGrid) spriteForItem(item InventoryItem) (*d2ui.Sprite, error) {
+	if sprite, found := g.sprites[item.GetItemCode()]; found {
+		return sprite, nil
+	}
+
+	sprite, err := g.uiManager.NewSprite(item.GetItemCode(), d2resource.PaletteSky)
+	if err != nil {
+		return nil, err
+	}
+
+	g.sprites[item.GetItemCode()] = sprite
+
+	return sprite, nil
+}
+
+// SlotToScreen converts a grid slot into the screen-space coordinates of its top-left corner.
+func (g *ItemGrid) SlotToScreen(slot ItemGridSlot) (x, y int) {
+	x = g.record.Grid.Left + slot.X*itemGridCellWidth
+	y = g.record.Grid.Top + slot.Y*itemGridCellHeight
+
+	return x, y
+}
+
+// ScreenToSlot converts screen-space coordinates into the grid slot underneath them.
+// found is false when the point falls outside the grid entirely.
+func (g *ItemGrid) ScreenToSlot(x, y int) (slot ItemGridSlot, found bool) {
+	relX, relY := x-g.record.Grid.Left, y-g.record.Grid.Top
+	if relX < 0 || relY < 0 {
+		return ItemGridSlot{}, false
+	}
+
+	slot = ItemGridSlot{X: relX / itemGridCellWidth, Y: relY / itemGridCellHeight}
+	if slot.X >= g.record.Grid.Box.Width || slot.Y >= g.record.Grid.Box.Height {
+		return ItemGridSlot{}, false
+	}
+
+	return slot, true
+}
+
+// EquippedSlotAt returns the equipped slot whose box contains the given screen-space
+// coordinates, if any.
+func (g *ItemGrid) EquippedSlotAt(x, y int) (slot d2enum.EquippedSlot, found bool) {
+	for candidate, box := range g.record.EquippedSlotBoxes {
+		if x >= box.Left && x < box.Left+box.Width && y >= box.Top && y < box.Top+box.Height {
+			return candidate, true
+		}
+	}
+
+	return d2enum.EquippedSlotNone, false
+}
+
+// ItemAt returns the item occupying the given grid slot, or nil if the slot is empty.
+func (g *ItemGrid) ItemAt(slot ItemGridSlot) InventoryItem {
+	for idx := range g.items {
+		item := g.items[idx]
+		width, height := g.GetInventoryItemSize(item)
+		origin := item.InventoryGridSlot()
+
+		if slot.X >= origin.X && slot.X < origin.X+width && slot.Y >= origin.Y && slot.Y < origin.Y+height {
+			return item
+		}
+	}
+
+	return nil
+}
+
+// PlaceAt attempts to place item with its top-left corner at slot, validating that its
+// footprint fits within the grid bounds and doesn't collide with another item. It
+// returns false without modifying the grid if the placement is invalid.
+func (g *ItemGrid) PlaceAt(item InventoryItem, slot ItemGridSlot) bool {
+	width, height := g.GetInventoryItemSize(item)
+
+	if slot.X < 0 || slot.Y < 0 || slot.X+width > g.record.Grid.Box.Width || slot.Y+height > g.record.Grid.Box.Height {
+		return false
+	}
+
+	if !g.footprintFree(slot, width, height) {
+		return false
+	}
+
+	g.place(item, slot)
+
+	return true
+}
+
+// Add places each item into the first free cells able to fit its footprint.
+func (g *ItemGrid) Add(items ...InventoryItem) (bool, error) {
+	for idx := range items {
+		slot, found := g.findFreeSlot(items[idx])
+		if !found {
+			return false, errors.New("no free inventory slot for item")
+		}
+
+		g.place(items[idx], slot)
+	}
+
+	return true, nil
+}
+
+// Remove detaches the given item from the grid, freeing the cells it occupied.
+func (g *ItemGrid) Remove(item InventoryItem) {
+	for idx := range g.items {
+		if g.items[idx] != item {
+			continue
+		}
+
+		g.occupyFootprint(item, false)
+		g.items = append(g.items[:idx], g.items[idx+1:]...)
+
+		return
+	}
+}
+
+// ChangeEquippedSlot sets (or clears, when item is nil) the item in the given equipped slot.
+func (g *ItemGrid) ChangeEquippedSlot(slot d2enum.EquippedSlot, item InventoryItem) {
+	if item == nil {
+		delete(g.equipped, slot)
+		return
+	}
+
+	g.equipped[slot] = item
+}
+
+// GetEquippedItem returns the item in the given equipped slot, or nil if it's empty.
+func (g *ItemGrid) GetEquippedItem(slot d2enum.EquippedSlot) InventoryItem {
+	return g.equipped[slot]
+}
+
+func (g *ItemGrid) findFreeSlot(item InventoryItem) (ItemGridSlot, bool) {
+	width, height := g.GetInventoryItemSize(item)
+
+	for y := 0; y <= g.record.Grid.Box.Height-height; y++ {
+		for x := 0; x <= g.record.Grid.Box.Width-width; x++ {
+			candidate := ItemGridSlot{X: x, Y: y}
+			if g.footprintFree(candidate, width, height) {
+				return candidate, true
+			}
+		}
+	}
+
+	return ItemGridSlot{}, false
+}
+
+func (g *ItemGrid) footprintFree(origin ItemGridSlot, width, height int) bool {
+	for dy := 0; dy < height; dy++ {
+		for dx := 0; dx < width; dx++ {
+			if g.occupied[ItemGridSlot{X: origin.X + dx, Y: origin.Y + dy}] {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func (g *ItemGrid) place(item InventoryItem, slot ItemGridSlot) {
+	item.SetInventoryGridSlot(slot)
+	g.items = append(g.items, item)
+	g.occupyFootprint(item, true)
+}
+
+func (g *ItemGrid) occupyFootprint(item InventoryItem, occupied bool) {
+	width, height := g.GetInventoryItemSize(item)
+	origin := item.InventoryGridSlot()
+
+	for dy := 0; dy < height; dy++ {
+		for dx := 0; dx < width; dx++ {
+			g.occupied[ItemGridSlot{X: origin.X + dx, Y: origin.Y + dy}] = occupied
+		}
+	}
+}
+
+// GetInventoryItemSize returns the item's WxH grid footprint.
+func (g *ItemGrid) GetInventoryItemSize(item InventoryItem) (width, height int) {
+	return item.GetInventoryItemSize()
+}
@@ -0,0 +1,85 @@
+package d2player
+
+import "testing"
+
+func TestItemGridPlaceAt(t *testing.T) {
+	tests := []struct {
+		name    string
+		slot    ItemGridSlot
+		existing *fakeInventoryItem
+		want    bool
+	}{
+		{
+			name: "fits in an empty grid",
+			slot: ItemGridSlot{X: 0, Y: 0},
+			want: true,
+		},
+		{
+			name: "collides with another item's footprint",
+			slot: ItemGridSlot{X: 1, Y: 1},
+			existing: &fakeInventoryItem{
+				code: "buc", codes: []string{"buc"}, width: 2, height: 2, slot: ItemGridSlot{X: 0, Y: 0},
+			},
+			want: false,
+		},
+		{
+			name: "out of bounds to the right",
+			slot: ItemGridSlot{X: 9, Y: 0},
+			want: false,
+		},
+		{
+			name: "out of bounds above the grid",
+			slot: ItemGridSlot{X: 0, Y: -1},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grid := newTestItemGrid(4, 4)
+
+			if tt.existing != nil {
+				if !grid.PlaceAt(tt.existing, tt.existing.InventoryGridSlot()) {
+					t.Fatalf("setup: failed to place existing item")
+				}
+			}
+
+			item := &fakeInventoryItem{code: "jav", codes: []string{"jav"}, width: 2, height: 2}
+
+			got := grid.PlaceAt(item, tt.slot)
+			if got != tt.want {
+				t.Errorf("PlaceAt(%+v) = %v, want %v", tt.slot, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestItemGridAddFindsNextFreeSlot(t *testing.T) {
+	grid := newTestItemGrid(2, 2)
+
+	first := &fakeInventoryItem{code: "rin", codes: []string{"rin"}, width: 1, height: 1}
+	second := &fakeInventoryItem{code: "amu", codes: []string{"amu"}, width: 1, height: 1}
+
+	if _, err := grid.Add(first, second); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	if first.InventoryGridSlot() == second.InventoryGridSlot() {
+		t.Fatalf("expected the two items to land in different slots, both got %+v", first.InventoryGridSlot())
+	}
+}
+
+func TestItemGridAddFailsWhenFull(t *testing.T) {
+	grid := newTestItemGrid(1, 1)
+
+	first := &fakeInventoryItem{code: "rin", codes: []string{"rin"}, width: 1, height: 1}
+	second := &fakeInventoryItem{code: "amu", codes: []string{"amu"}, width: 1, height: 1}
+
+	if _, err := grid.Add(first); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	if _, err := grid.Add(second); err == nil {
+		t.Fatal("expected Add to fail once the grid is full")
+	}
+}